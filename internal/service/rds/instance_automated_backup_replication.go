@@ -1,28 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
 package rds
 
 import (
-	"fmt"
+	"context"
 	"log"
+	"time"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
-	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+// @SDKResource("aws_db_instance_automated_backup_replication", name="DB Instance Automated Backup Replication")
+// @Tags(identifierAttribute="id")
 func ResourceInstanceAutomatedBackupReplication() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceInstanceAutomatedBackupReplicationCreate,
-		Read:   resourceInstanceAutomatedBackupReplicationRead,
-		Delete: resourceInstanceAutomatedBackupReplicationDelete,
+		CreateWithoutTimeout: resourceInstanceAutomatedBackupReplicationCreate,
+		ReadWithoutTimeout:   resourceInstanceAutomatedBackupReplicationRead,
+		UpdateWithoutTimeout: resourceInstanceAutomatedBackupReplicationUpdate,
+		DeleteWithoutTimeout: resourceInstanceAutomatedBackupReplicationDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: verify.SetTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"kms_key_id": {
 				Type:         schema.TypeString,
@@ -33,7 +55,6 @@ func ResourceInstanceAutomatedBackupReplication() *schema.Resource {
 			},
 			"retention_period": {
 				Type:     schema.TypeInt,
-				ForceNew: true,
 				Optional: true,
 				Default:  7,
 			},
@@ -43,15 +64,18 @@ func ResourceInstanceAutomatedBackupReplication() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 	}
 }
 
-func resourceInstanceAutomatedBackupReplicationCreate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RDSConn
+func resourceInstanceAutomatedBackupReplicationCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSClient(ctx)
 
 	input := &rds.StartDBInstanceAutomatedBackupsReplicationInput{
-		BackupRetentionPeriod: aws.Int64(int64(d.Get("retention_period").(int))),
+		BackupRetentionPeriod: aws.Int32(int32(d.Get("retention_period").(int))),
 		SourceDBInstanceArn:   aws.String(d.Get("source_db_instance_arn").(string)),
 	}
 
@@ -59,82 +83,248 @@ func resourceInstanceAutomatedBackupReplicationCreate(d *schema.ResourceData, me
 		input.KmsKeyId = aws.String(v.(string))
 	}
 
-	log.Printf("[DEBUG] Starting RDS instance automated backup replication: %s", input)
-	output, err := conn.StartDBInstanceAutomatedBackupsReplication(input)
+	log.Printf("[DEBUG] Starting RDS Instance Automated Backup Replication: %#v", input)
+	output, err := conn.StartDBInstanceAutomatedBackupsReplication(ctx, input)
 
 	if err != nil {
-		return fmt.Errorf("error starting RDS instance automated backup replication: %w", err)
+		return sdkdiag.AppendErrorf(diags, "starting RDS Instance Automated Backup Replication: %s", err)
 	}
 
-	d.SetId(aws.StringValue(output.DBInstanceAutomatedBackup.DBInstanceAutomatedBackupsArn))
+	d.SetId(aws.ToString(output.DBInstanceAutomatedBackup.DBInstanceAutomatedBackupsArn))
 
-	if _, err := waitDBInstanceAutomatedBackupCreated(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
-		return fmt.Errorf("error waiting for DB instance automated backup (%s) create: %w", d.Id(), err)
+	if _, err := waitDBInstanceAutomatedBackupCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for RDS Instance Automated Backup Replication (%s) create: %s", d.Id(), err)
 	}
 
-	return resourceInstanceAutomatedBackupReplicationRead(d, meta)
+	return append(diags, resourceInstanceAutomatedBackupReplicationRead(ctx, d, meta)...)
 }
 
-func resourceInstanceAutomatedBackupReplicationRead(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RDSConn
+func resourceInstanceAutomatedBackupReplicationRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSClient(ctx)
 
-	backup, err := FindDBInstanceAutomatedBackupByARN(conn, d.Id())
+	backup, err := findDBInstanceAutomatedBackupByARN(ctx, conn, d.Id())
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
-		log.Printf("[WARN] RDS instance automated backup replication %s not found, removing from state", d.Id())
+		log.Printf("[WARN] RDS Instance Automated Backup Replication (%s) not found, removing from state", d.Id())
 		d.SetId("")
-		return nil
+		return diags
 	}
 
 	if err != nil {
-		return fmt.Errorf("error reading RDS instance automated backup replication (%s): %w", d.Id(), err)
+		return sdkdiag.AppendErrorf(diags, "reading RDS Instance Automated Backup Replication (%s): %s", d.Id(), err)
 	}
 
 	d.Set("kms_key_id", backup.KmsKeyId)
 	d.Set("retention_period", backup.BackupRetentionPeriod)
 	d.Set("source_db_instance_arn", backup.DBInstanceArn)
 
-	return nil
+	return diags
+}
+
+func resourceInstanceAutomatedBackupReplicationUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSClient(ctx)
+
+	if d.HasChange("retention_period") {
+		// The RDS API has no modify verb for an in-flight automated backup
+		// replication, so a retention change is applied by stopping and
+		// restarting replication. Starting replication always mints a new
+		// DBInstanceAutomatedBackupsArn, so the resource's id necessarily
+		// changes across this Update -- there is no API-level way to keep
+		// the old ARN alive under the new retention setting.
+		sourceDBInstanceARN := d.Get("source_db_instance_arn").(string)
+
+		backup, err := findDBInstanceAutomatedBackupByARN(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS Instance Automated Backup Replication (%s): %s", d.Id(), err)
+		}
+
+		dbInstanceID := aws.ToString(backup.DBInstanceIdentifier)
+		sourceDatabaseARN, err := arn.Parse(aws.ToString(backup.DBInstanceArn))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS Instance Automated Backup Replication (%s): parsing source DB instance ARN: %s", d.Id(), err)
+		}
+
+		log.Printf("[DEBUG] Stopping RDS Instance Automated Backup Replication for update: %s", d.Id())
+		_, err = conn.StopDBInstanceAutomatedBackupsReplication(ctx, &rds.StopDBInstanceAutomatedBackupsReplicationInput{
+			SourceDBInstanceArn: aws.String(sourceDBInstanceARN),
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS Instance Automated Backup Replication (%s): %s", d.Id(), err)
+		}
+
+		// Cross-Region replication must wait for teardown using a client
+		// scoped to the source Region, same as the Delete path.
+		waitConn := conn
+		if sourceRegion := sourceDatabaseARN.Region; sourceRegion != meta.(*conns.AWSClient).Region(ctx) {
+			waitConn = meta.(*conns.AWSClient).RDSClient(ctx, func(o *rds.Options) {
+				o.Region = sourceRegion
+			})
+		}
+
+		if _, err := waitDBInstanceAutomatedBackupDeleted(ctx, waitConn, dbInstanceID, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS Instance Automated Backup Replication (%s): waiting for replication to stop: %s", d.Id(), err)
+		}
+
+		input := &rds.StartDBInstanceAutomatedBackupsReplicationInput{
+			BackupRetentionPeriod: aws.Int32(int32(d.Get("retention_period").(int))),
+			SourceDBInstanceArn:   aws.String(sourceDBInstanceARN),
+		}
+
+		if v, ok := d.GetOk("kms_key_id"); ok {
+			input.KmsKeyId = aws.String(v.(string))
+		}
+
+		output, err := conn.StartDBInstanceAutomatedBackupsReplication(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS Instance Automated Backup Replication (%s): %s", d.Id(), err)
+		}
+
+		d.SetId(aws.ToString(output.DBInstanceAutomatedBackup.DBInstanceAutomatedBackupsArn))
+
+		if _, err := waitDBInstanceAutomatedBackupCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS Instance Automated Backup Replication (%s): waiting for replication to restart: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceInstanceAutomatedBackupReplicationRead(ctx, d, meta)...)
 }
 
-func resourceInstanceAutomatedBackupReplicationDelete(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).RDSConn
+func resourceInstanceAutomatedBackupReplicationDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RDSClient(ctx)
 
-	backup, err := FindDBInstanceAutomatedBackupByARN(conn, d.Id())
+	backup, err := findDBInstanceAutomatedBackupByARN(ctx, conn, d.Id())
 
 	if tfresource.NotFound(err) {
-		return nil
+		return diags
 	}
 
 	if err != nil {
-		return fmt.Errorf("error reading RDS instance automated backup replication (%s): %w", d.Id(), err)
+		return sdkdiag.AppendErrorf(diags, "reading RDS Instance Automated Backup Replication (%s): %s", d.Id(), err)
 	}
 
-	dbInstanceID := aws.StringValue(backup.DBInstanceIdentifier)
-	sourceDatabaseARN, err := arn.Parse(aws.StringValue(backup.DBInstanceArn))
+	dbInstanceID := aws.ToString(backup.DBInstanceIdentifier)
+	sourceDatabaseARN, err := arn.Parse(aws.ToString(backup.DBInstanceArn))
 
 	if err != nil {
-		return err
+		return sdkdiag.AppendErrorf(diags, "parsing RDS Instance Automated Backup Replication (%s) source DB instance ARN: %s", d.Id(), err)
 	}
 
-	log.Printf("[DEBUG] Stopping RDS instance automated backup replication: %s", d.Id())
-	_, err = conn.StopDBInstanceAutomatedBackupsReplication(&rds.StopDBInstanceAutomatedBackupsReplicationInput{
+	log.Printf("[DEBUG] Stopping RDS Instance Automated Backup Replication: %s", d.Id())
+	_, err = conn.StopDBInstanceAutomatedBackupsReplication(ctx, &rds.StopDBInstanceAutomatedBackupsReplicationInput{
 		SourceDBInstanceArn: aws.String(d.Get("source_db_instance_arn").(string)),
 	})
 
+	if errs.IsA[*awstypes.DBInstanceAutomatedBackupNotFoundFault](err) {
+		return diags
+	}
+
 	if err != nil {
-		return fmt.Errorf("error stopping RDS instance automated backup replication (%s): %w", d.Id(), err)
+		return sdkdiag.AppendErrorf(diags, "stopping RDS Instance Automated Backup Replication (%s): %s", d.Id(), err)
+	}
+
+	// The replicated backup is deleted from the source Region, so waiting for
+	// teardown to complete must use a client scoped to that Region -- via the
+	// provider's client cache, not a bare SDK client built outside its
+	// retry/logging middleware -- whenever replication crosses Regions.
+	waitConn := conn
+	if sourceRegion := sourceDatabaseARN.Region; sourceRegion != meta.(*conns.AWSClient).Region(ctx) {
+		waitConn = meta.(*conns.AWSClient).RDSClient(ctx, func(o *rds.Options) {
+			o.Region = sourceRegion
+		})
 	}
 
-	// Create a new client to the source region.
-	sourceDatabaseConn := conn
-	if sourceDatabaseARN.Region != meta.(*conns.AWSClient).Region {
-		sourceDatabaseConn = rds.New(meta.(*conns.AWSClient).Session, aws.NewConfig().WithRegion(sourceDatabaseARN.Region))
+	if _, err := waitDBInstanceAutomatedBackupDeleted(ctx, waitConn, dbInstanceID, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for RDS Instance Automated Backup Replication (%s) delete: %s", d.Id(), err)
 	}
 
-	if _, err := waitDBInstanceAutomatedBackupDeleted(sourceDatabaseConn, dbInstanceID, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
-		return fmt.Errorf("error waiting for DB instance automated backup (%s) delete: %w", d.Id(), err)
+	return diags
+}
+
+func findDBInstanceAutomatedBackupByARN(ctx context.Context, conn *rds.Client, arn string) (*awstypes.DBInstanceAutomatedBackup, error) {
+	input := &rds.DescribeDBInstanceAutomatedBackupsInput{
+		DBInstanceAutomatedBackupsArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeDBInstanceAutomatedBackups(ctx, input)
+
+	if errs.IsA[*awstypes.DBInstanceAutomatedBackupNotFoundFault](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.DBInstanceAutomatedBackups) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.DBInstanceAutomatedBackups); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return &output.DBInstanceAutomatedBackups[0], nil
+}
+
+func statusDBInstanceAutomatedBackup(ctx context.Context, conn *rds.Client, arn string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findDBInstanceAutomatedBackupByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.ToString(output.Status), nil
+	}
+}
+
+func waitDBInstanceAutomatedBackupCreated(ctx context.Context, conn *rds.Client, arn string, timeout time.Duration) (*awstypes.DBInstanceAutomatedBackup, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"pending"},
+		Target:     []string{"replicating"},
+		Refresh:    statusDBInstanceAutomatedBackup(ctx, conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DBInstanceAutomatedBackup); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDBInstanceAutomatedBackupDeleted(ctx context.Context, conn *rds.Client, dbInstanceID, arn string, timeout time.Duration) (*awstypes.DBInstanceAutomatedBackup, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"replicating", "stopping"},
+		Target:     []string{},
+		Refresh:    statusDBInstanceAutomatedBackup(ctx, conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	log.Printf("[DEBUG] Waiting for RDS Instance Automated Backup Replication (%s) for DB instance %s to delete", arn, dbInstanceID)
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DBInstanceAutomatedBackup); ok {
+		return output, err
 	}
 
-	return nil
+	return nil, err
 }
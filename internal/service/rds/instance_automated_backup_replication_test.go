@@ -0,0 +1,222 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRDSInstanceAutomatedBackupReplication_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_db_instance_automated_backup_replication.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceAutomatedBackupReplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceAutomatedBackupReplicationConfig_basic(rName, 7),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceAutomatedBackupReplicationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "source_db_instance_arn", "aws_db_instance.source", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "retention_period", "7"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRDSInstanceAutomatedBackupReplication_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_db_instance_automated_backup_replication.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceAutomatedBackupReplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceAutomatedBackupReplicationConfig_basic(rName, 7),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceAutomatedBackupReplicationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "retention_period", "7"),
+				),
+			},
+			{
+				// Retention-only changes must apply via Update, not force a
+				// destroy/recreate of the replication.
+				Config: testAccInstanceAutomatedBackupReplicationConfig_basic(rName, 14),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceAutomatedBackupReplicationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "retention_period", "14"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSInstanceAutomatedBackupReplication_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_db_instance_automated_backup_replication.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceAutomatedBackupReplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceAutomatedBackupReplicationConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceAutomatedBackupReplicationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccInstanceAutomatedBackupReplicationConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceAutomatedBackupReplicationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckInstanceAutomatedBackupReplicationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSClient(ctx)
+
+		_, err := testAccFindInstanceAutomatedBackupByARN(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckInstanceAutomatedBackupReplicationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_db_instance_automated_backup_replication" {
+				continue
+			}
+
+			_, err := testAccFindInstanceAutomatedBackupByARN(ctx, conn, rs.Primary.ID)
+
+			if err == nil {
+				return fmt.Errorf("RDS Instance Automated Backup Replication %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccFindInstanceAutomatedBackupByARN(ctx context.Context, conn *rds.Client, arn string) (*awstypes.DBInstanceAutomatedBackup, error) {
+	output, err := conn.DescribeDBInstanceAutomatedBackups(ctx, &rds.DescribeDBInstanceAutomatedBackupsInput{
+		DBInstanceAutomatedBackupsArn: aws.String(arn),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output.DBInstanceAutomatedBackups) == 0 {
+		return nil, fmt.Errorf("RDS Instance Automated Backup Replication %s not found", arn)
+	}
+
+	return &output.DBInstanceAutomatedBackups[0], nil
+}
+
+func testAccInstanceAutomatedBackupReplicationConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_db_instance" "source" {
+  identifier          = %[1]q
+  allocated_storage   = 10
+  engine              = "mysql"
+  instance_class      = "db.t3.micro"
+  username            = "tfacctest"
+  password            = "avoid-plaintext-passwords"
+  skip_final_snapshot = true
+  backup_retention_period = 1
+}
+`, rName)
+}
+
+func testAccInstanceAutomatedBackupReplicationConfig_basic(rName string, retentionPeriod int) string {
+	return acctest.ConfigCompose(
+		testAccInstanceAutomatedBackupReplicationConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_db_instance_automated_backup_replication" "test" {
+  source_db_instance_arn = aws_db_instance.source.arn
+  retention_period        = %[1]d
+}
+`, retentionPeriod))
+}
+
+func testAccInstanceAutomatedBackupReplicationConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(
+		testAccInstanceAutomatedBackupReplicationConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_db_instance_automated_backup_replication" "test" {
+  source_db_instance_arn = aws_db_instance.source.arn
+
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1))
+}
+
+func testAccInstanceAutomatedBackupReplicationConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(
+		testAccInstanceAutomatedBackupReplicationConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_db_instance_automated_backup_replication" "test" {
+  source_db_instance_arn = aws_db_instance.source.arn
+
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -25,6 +25,11 @@ import (
 )
 
 // @SDKResource("aws_route53_resolver_rule_association", name="Rule Association")
+//
+// Rule associations have no ARN and are not one of Route 53 Resolver's
+// documented taggable resource types (only rules, endpoints, query log
+// configs, DNSSEC configs, and firewall rule groups/domain lists are), so
+// this resource does not support tags/tags_all.
 func resourceRuleAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRuleAssociationCreate,
@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53resolver
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_route53_resolver_rule_association", name="Rule Association")
+func dataSourceRuleAssociation() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceRuleAssociationRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrID: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resolver_rule_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrVPCID: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+		},
+	}
+}
+
+func dataSourceRuleAssociationRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53ResolverClient(ctx)
+
+	var ruleAssociation *awstypes.ResolverRuleAssociation
+
+	if v, ok := d.GetOk(names.AttrID); ok {
+		association, err := findResolverRuleAssociationByID(ctx, conn, v.(string))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Route53 Resolver Rule Association (%s): %s", v.(string), err)
+		}
+
+		ruleAssociation = association
+	} else {
+		ruleID, ruleIDOK := d.GetOk("resolver_rule_id")
+		vpcID, vpcIDOK := d.GetOk(names.AttrVPCID)
+
+		if !ruleIDOK || !vpcIDOK {
+			return sdkdiag.AppendErrorf(diags, "one of %q or both %q and %q must be specified", names.AttrID, "resolver_rule_id", names.AttrVPCID)
+		}
+
+		associationIDs, err := findResolverRuleAssociationsByRuleID(ctx, conn, ruleID.(string))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Route53 Resolver Rule Associations for rule (%s): %s", ruleID.(string), err)
+		}
+
+		associationID, ok := associationIDs[vpcID.(string)]
+
+		if !ok {
+			return sdkdiag.AppendErrorf(diags, "no Route53 Resolver Rule Association found for rule (%s) and VPC (%s)", ruleID.(string), vpcID.(string))
+		}
+
+		association, err := findResolverRuleAssociationByID(ctx, conn, associationID)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Route53 Resolver Rule Association (%s): %s", associationID, err)
+		}
+
+		ruleAssociation = association
+	}
+
+	id := aws.ToString(ruleAssociation.Id)
+	d.SetId(id)
+	d.Set(names.AttrID, id)
+	d.Set(names.AttrName, ruleAssociation.Name)
+	d.Set("resolver_rule_id", ruleAssociation.ResolverRuleId)
+	d.Set(names.AttrStatus, ruleAssociation.Status)
+	d.Set(names.AttrVPCID, ruleAssociation.VPCId)
+
+	return diags
+}
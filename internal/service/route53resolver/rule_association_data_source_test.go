@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53resolver_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRoute53ResolverRuleAssociationDataSource_id(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_route53_resolver_rule_association.test"
+	resourceName := "aws_route53_resolver_rule_association.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ResolverServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleAssociationDataSourceConfig_id(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrID, resourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "resolver_rule_id", resourceName, "resolver_rule_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrVPCID, resourceName, names.AttrVPCID),
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrStatus),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53ResolverRuleAssociationDataSource_ruleAndVPC(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_route53_resolver_rule_association.test"
+	resourceName := "aws_route53_resolver_rule_association.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ResolverServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleAssociationDataSourceConfig_ruleAndVPC(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrID, resourceName, names.AttrID),
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrStatus),
+				),
+			},
+		},
+	})
+}
+
+func testAccRuleAssociationDataSourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route53_resolver_rule" "test" {
+  domain_name = "%[1]s.example.com"
+  rule_type   = "SYSTEM"
+}
+
+resource "aws_route53_resolver_rule_association" "test" {
+  resolver_rule_id = aws_route53_resolver_rule.test.id
+  vpc_id           = aws_vpc.test.id
+}
+`, rName)
+}
+
+func testAccRuleAssociationDataSourceConfig_id(rName string) string {
+	return acctest.ConfigCompose(
+		testAccRuleAssociationDataSourceConfig_base(rName),
+		`
+data "aws_route53_resolver_rule_association" "test" {
+  id = aws_route53_resolver_rule_association.test.id
+}
+`)
+}
+
+func testAccRuleAssociationDataSourceConfig_ruleAndVPC(rName string) string {
+	return acctest.ConfigCompose(
+		testAccRuleAssociationDataSourceConfig_base(rName),
+		`
+data "aws_route53_resolver_rule_association" "test" {
+  resolver_rule_id = aws_route53_resolver_rule_association.test.resolver_rule_id
+  vpc_id           = aws_route53_resolver_rule_association.test.vpc_id
+}
+`)
+}
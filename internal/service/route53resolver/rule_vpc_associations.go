@@ -0,0 +1,271 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53resolver
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// ruleVPCAssociationConcurrency bounds how many AssociateResolverRule /
+// DisassociateResolverRule calls are in flight at once, so a rule with
+// dozens of VPCs doesn't overrun the Resolver API's per-account request rate.
+const ruleVPCAssociationConcurrency = 10
+
+// @SDKResource("aws_route53_resolver_rule_vpc_associations", name="Rule VPC Associations")
+func resourceRuleVPCAssociations() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRuleVPCAssociationsCreate,
+		ReadWithoutTimeout:   resourceRuleVPCAssociationsRead,
+		UpdateWithoutTimeout: resourceRuleVPCAssociationsUpdate,
+		DeleteWithoutTimeout: resourceRuleVPCAssociationsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"association_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"resolver_rule_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"vpc_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 64),
+				},
+			},
+		},
+	}
+}
+
+func resourceRuleVPCAssociationsCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53ResolverClient(ctx)
+
+	ruleID := d.Get("resolver_rule_id").(string)
+	vpcIDs := flex.ExpandStringValueSet(d.Get("vpc_ids").(*schema.Set))
+
+	d.SetId(ruleID)
+
+	if err := associateRuleVPCs(ctx, conn, ruleID, vpcIDs, d.Timeout(schema.TimeoutCreate)); err != nil {
+		diags = sdkdiag.AppendErrorf(diags, "creating Route53 Resolver Rule VPC Associations (%s): %s", d.Id(), err)
+	}
+
+	// Some VPCs may have associated successfully even if others failed, so
+	// always refresh state from the read before returning.
+	return append(diags, resourceRuleVPCAssociationsRead(ctx, d, meta)...)
+}
+
+func resourceRuleVPCAssociationsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53ResolverClient(ctx)
+
+	associationIDs, err := findResolverRuleAssociationsByRuleID(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route53 Resolver Rule VPC Associations (%s): %s", d.Id(), err)
+	}
+
+	if len(associationIDs) == 0 && !d.IsNewResource() {
+		log.Printf("[WARN] Route53 Resolver Rule VPC Associations (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	vpcIDs := make([]string, 0, len(associationIDs))
+	for vpcID := range associationIDs {
+		vpcIDs = append(vpcIDs, vpcID)
+	}
+
+	d.Set("association_ids", associationIDs)
+	d.Set("resolver_rule_id", d.Id())
+	d.Set("vpc_ids", vpcIDs)
+
+	return diags
+}
+
+func resourceRuleVPCAssociationsUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53ResolverClient(ctx)
+
+	if d.HasChange("vpc_ids") {
+		o, n := d.GetChange("vpc_ids")
+		add := n.(*schema.Set).Difference(o.(*schema.Set))
+		del := o.(*schema.Set).Difference(n.(*schema.Set))
+
+		if del.Len() > 0 {
+			if err := disassociateRuleVPCs(ctx, conn, d.Id(), flex.ExpandStringValueSet(del), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				diags = sdkdiag.AppendErrorf(diags, "updating Route53 Resolver Rule VPC Associations (%s): %s", d.Id(), err)
+			}
+		}
+
+		if add.Len() > 0 {
+			if err := associateRuleVPCs(ctx, conn, d.Id(), flex.ExpandStringValueSet(add), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				diags = sdkdiag.AppendErrorf(diags, "updating Route53 Resolver Rule VPC Associations (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
+	// Some VPCs may have (dis)associated successfully even if others failed,
+	// so always refresh state from the read before returning.
+	return append(diags, resourceRuleVPCAssociationsRead(ctx, d, meta)...)
+}
+
+func resourceRuleVPCAssociationsDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53ResolverClient(ctx)
+
+	vpcIDs := flex.ExpandStringValueSet(d.Get("vpc_ids").(*schema.Set))
+
+	log.Printf("[DEBUG] Deleting Route53 Resolver Rule VPC Associations: %s", d.Id())
+	if err := disassociateRuleVPCs(ctx, conn, d.Id(), vpcIDs, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Route53 Resolver Rule VPC Associations (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// associateRuleVPCs fans out AssociateResolverRule calls across a bounded
+// worker pool and waits for every association to settle before returning,
+// so a single slow VPC doesn't serialize the rest.
+func associateRuleVPCs(ctx context.Context, conn *route53resolver.Client, ruleID string, vpcIDs []string, timeout time.Duration) error {
+	return fanOutRuleVPCs(vpcIDs, func(vpcID string) error {
+		output, err := conn.AssociateResolverRule(ctx, &route53resolver.AssociateResolverRuleInput{
+			ResolverRuleId: aws.String(ruleID),
+			VPCId:          aws.String(vpcID),
+		})
+
+		if errs.IsA[*awstypes.ResourceExistsException](err) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("associating VPC (%s): %w", vpcID, err)
+		}
+
+		if _, err := waitRuleAssociationCreated(ctx, conn, aws.ToString(output.ResolverRuleAssociation.Id), timeout); err != nil {
+			return fmt.Errorf("waiting for VPC (%s) association create: %w", vpcID, err)
+		}
+
+		return nil
+	})
+}
+
+// disassociateRuleVPCs mirrors associateRuleVPCs for teardown, fanning
+// DisassociateResolverRule calls out across the same bounded worker pool.
+func disassociateRuleVPCs(ctx context.Context, conn *route53resolver.Client, ruleID string, vpcIDs []string, timeout time.Duration) error {
+	return fanOutRuleVPCs(vpcIDs, func(vpcID string) error {
+		output, err := conn.DisassociateResolverRule(ctx, &route53resolver.DisassociateResolverRuleInput{
+			ResolverRuleId: aws.String(ruleID),
+			VPCId:          aws.String(vpcID),
+		})
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("disassociating VPC (%s): %w", vpcID, err)
+		}
+
+		if _, err := waitRuleAssociationDeleted(ctx, conn, aws.ToString(output.ResolverRuleAssociation.Id), timeout); err != nil {
+			return fmt.Errorf("waiting for VPC (%s) association delete: %w", vpcID, err)
+		}
+
+		return nil
+	})
+}
+
+// fanOutRuleVPCs runs fn for every VPC ID concurrently, bounded by
+// ruleVPCAssociationConcurrency, and fans the resulting errors back in.
+func fanOutRuleVPCs(vpcIDs []string, fn func(vpcID string) error) error {
+	sem := make(chan struct{}, ruleVPCAssociationConcurrency)
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var merr *multierror.Error
+
+	for _, vpcID := range vpcIDs {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(vpcID string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if err := fn(vpcID); err != nil {
+				mu.Lock()
+				merr = multierror.Append(merr, err)
+				mu.Unlock()
+			}
+		}(vpcID)
+	}
+
+	wg.Wait()
+
+	return merr.ErrorOrNil()
+}
+
+// findResolverRuleAssociationsByRuleID returns every non-deleting
+// association for a rule as a map of VPC ID to association ID.
+func findResolverRuleAssociationsByRuleID(ctx context.Context, conn *route53resolver.Client, ruleID string) (map[string]string, error) {
+	input := &route53resolver.ListResolverRuleAssociationsInput{
+		Filters: []awstypes.Filter{
+			{
+				Name:   aws.String("ResolverRuleId"),
+				Values: []string{ruleID},
+			},
+		},
+	}
+
+	associationIDs := make(map[string]string)
+	paginator := route53resolver.NewListResolverRuleAssociationsPaginator(conn, input)
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, association := range page.ResolverRuleAssociations {
+			if association.Status == awstypes.ResolverRuleAssociationStatusDeleting {
+				continue
+			}
+			associationIDs[aws.ToString(association.VPCId)] = aws.ToString(association.Id)
+		}
+	}
+
+	return associationIDs, nil
+}
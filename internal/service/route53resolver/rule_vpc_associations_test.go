@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53resolver_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRoute53ResolverRuleVPCAssociations_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_resolver_rule_vpc_associations.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ResolverServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleVPCAssociationsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleVPCAssociationsConfig_basic(rName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRuleVPCAssociationsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "vpc_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "association_ids.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRoute53ResolverRuleVPCAssociations_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_resolver_rule_vpc_associations.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ResolverServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleVPCAssociationsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuleVPCAssociationsConfig_basic(rName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRuleVPCAssociationsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "vpc_ids.#", "1"),
+				),
+			},
+			{
+				// Growing the VPC set exercises the add side of the diffing
+				// and the concurrent associate worker pool.
+				Config: testAccRuleVPCAssociationsConfig_basic(rName, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRuleVPCAssociationsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "vpc_ids.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "association_ids.%", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRuleVPCAssociationsExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53ResolverClient(ctx)
+
+		associations, err := testAccListRuleVPCAssociations(ctx, conn, rs.Primary.Attributes["resolver_rule_id"])
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := len(associations), rs.Primary.Attributes["vpc_ids.#"]; fmt.Sprint(got) != want {
+			return fmt.Errorf("expected %s Route53 Resolver Rule VPC Associations for rule %s, got %d", want, rs.Primary.ID, got)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRuleVPCAssociationsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53ResolverClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_route53_resolver_rule_vpc_associations" {
+				continue
+			}
+
+			associations, err := testAccListRuleVPCAssociations(ctx, conn, rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			if len(associations) > 0 {
+				return fmt.Errorf("Route53 Resolver Rule VPC Associations %s still exist", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccListRuleVPCAssociations(ctx context.Context, conn *route53resolver.Client, ruleID string) ([]awstypes.ResolverRuleAssociation, error) {
+	input := &route53resolver.ListResolverRuleAssociationsInput{
+		Filters: []awstypes.Filter{
+			{
+				Name:   aws.String("ResolverRuleId"),
+				Values: []string{ruleID},
+			},
+		},
+	}
+
+	var associations []awstypes.ResolverRuleAssociation
+	paginator := route53resolver.NewListResolverRuleAssociationsPaginator(conn, input)
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, association := range page.ResolverRuleAssociations {
+			if association.Status != awstypes.ResolverRuleAssociationStatusDeleting {
+				associations = append(associations, association)
+			}
+		}
+	}
+
+	return associations, nil
+}
+
+func testAccRuleVPCAssociationsConfig_basic(rName string, vpcCount int) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigAvailableAZsNoOptIn(),
+		fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  count      = %[2]d
+  cidr_block = "10.${count.index}.0.0/16"
+
+  tags = {
+    Name = "%[1]s-${count.index}"
+  }
+}
+
+resource "aws_route53_resolver_rule" "test" {
+  domain_name = "%[1]s.example.com"
+  rule_type   = "SYSTEM"
+}
+
+resource "aws_route53_resolver_rule_vpc_associations" "test" {
+  resolver_rule_id = aws_route53_resolver_rule.test.id
+  vpc_ids          = aws_vpc.test[*].id
+}
+`, rName, vpcCount))
+}